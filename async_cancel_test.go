@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestCancelAsyncRace exercises the race between an in-flight async
+// operation releasing its own cancellation handle and a concurrent
+// CancelAsync call for that same handle: cancelling must stop the
+// goroutine, and a second CancelAsync against a handle that has already
+// been released must report an error rather than acting on (or panicking
+// over) a reused/unknown handle.
+func TestCancelAsyncRace(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	handle := registerAsyncCancel(cancel)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer releaseAsyncCancel(handle)
+		<-ctx.Done()
+	}()
+
+	CancelAsync(handle)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("goroutine did not observe cancellation via CancelAsync")
+	}
+
+	clearLastError()
+	CancelAsync(handle)
+	msg := GetLastError()
+	if msg == nil {
+		t.Fatal("expected an error to be recorded for re-cancelling a released handle")
+	}
+	FreeString(msg)
+}
@@ -0,0 +1,121 @@
+package main
+
+/*
+#include <stddef.h>
+#include "errors.h"
+
+typedef double (*callback_t)(double);
+
+// thunk_addr is defined in callbacks_gen.go, alongside the forward
+// declarations of the cbThunkN pool it addresses (the thunks themselves are
+// in callbacks_thunks_gen.go); it has external linkage there so it can be
+// declared here without redefining it.
+extern void* thunk_addr(int i);
+*/
+import "C"
+
+import (
+	"errors"
+	"sync"
+	"unsafe"
+)
+
+//go:generate go run ./tools/gencallbacks
+
+// callbackPoolSize bounds how many Go closures can have a live C-callable
+// trampoline at once. RegisterCallback fails once every slot is taken;
+// callers are expected to UnregisterCallback when a callback_t is no
+// longer needed rather than leaking slots. It is derived from
+// generatedCallbackPoolSize (defined in the generated callbacks_gen.go) so
+// that file and this one can never disagree about the size of the pool; to
+// change it, edit poolSize in tools/gencallbacks/main.go and re-run
+// `go generate`.
+const callbackPoolSize = generatedCallbackPoolSize
+
+// ErrCallbacksExhausted is the reason RegisterCallback returned a nil
+// callback_t: every trampoline slot in the pool is currently assigned.
+var ErrCallbacksExhausted = errors.New("callbacks: trampoline pool exhausted")
+
+var (
+	callbackMu      sync.RWMutex
+	callbackSlots   [callbackPoolSize]func(float64) float64
+	lastCallbackErr error
+)
+
+// RegisterCallback claims a free trampoline slot for fn and returns a
+// C-callable function pointer that dispatches into it. The returned
+// callback_t remains valid until the matching UnregisterCallback call.
+// If the pool is full, it returns nil; LastCallbackError and the package-
+// wide GetLastError/GetLastErrorCode both report ErrCallbacksExhausted as
+// the cause.
+func RegisterCallback(fn func(float64) float64) C.callback_t {
+	clearLastError()
+	callbackMu.Lock()
+	defer callbackMu.Unlock()
+
+	for i := range callbackSlots {
+		if callbackSlots[i] == nil {
+			callbackSlots[i] = fn
+			lastCallbackErr = nil
+			return C.callback_t(C.thunk_addr(C.int(i)))
+		}
+	}
+	lastCallbackErr = ErrCallbacksExhausted
+	setLastError(C.FFI_ERR_CALLBACKS_EXHAUSTED, ErrCallbacksExhausted.Error())
+	return nil
+}
+
+// LastCallbackError returns the reason the previous RegisterCallback call
+// returned nil, or nil if it succeeded.
+func LastCallbackError() error {
+	callbackMu.RLock()
+	defer callbackMu.RUnlock()
+	return lastCallbackErr
+}
+
+// UnregisterCallback frees the trampoline slot backing cb so it can be
+// reused by a later RegisterCallback call. Unregistering an unknown or
+// already-freed callback_t is a no-op.
+func UnregisterCallback(cb C.callback_t) {
+	idx := slotForThunk(cb)
+	if idx < 0 {
+		return
+	}
+	callbackMu.Lock()
+	callbackSlots[idx] = nil
+	callbackMu.Unlock()
+}
+
+// ResetCallbacks clears every trampoline slot, regardless of whether it
+// was unregistered. Intended for use between test cases.
+func ResetCallbacks() {
+	callbackMu.Lock()
+	defer callbackMu.Unlock()
+	for i := range callbackSlots {
+		callbackSlots[i] = nil
+	}
+}
+
+// slotForThunk maps a callback_t previously returned by RegisterCallback
+// back to its slot index, or -1 if it does not belong to this pool.
+func slotForThunk(cb C.callback_t) int {
+	addr := unsafe.Pointer(cb)
+	for i := 0; i < callbackPoolSize; i++ {
+		if addr == C.thunk_addr(C.int(i)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// dispatchCallback is called from C by each cbThunkN with its own slot
+// index; it looks up the registered Go closure and invokes it.
+func dispatchCallback(slot C.int, val C.double) C.double {
+	callbackMu.RLock()
+	fn := callbackSlots[int(slot)]
+	callbackMu.RUnlock()
+	if fn == nil {
+		return 0
+	}
+	return C.double(fn(float64(val)))
+}
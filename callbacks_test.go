@@ -0,0 +1,41 @@
+package main
+
+import "testing"
+
+// TestCallbackPoolExhaustionAndReuse fills every trampoline slot, checks
+// that RegisterCallback reports ErrCallbacksExhausted once the pool is
+// full, and confirms that freeing a slot with UnregisterCallback lets it
+// be claimed and dispatched through again.
+//
+// This file cannot itself import "C" (cgo is not supported in _test.go
+// files), so every value it passes around is one whose type cgo functions
+// already infer for it, rather than one it names explicitly.
+func TestCallbackPoolExhaustionAndReuse(t *testing.T) {
+	ResetCallbacks()
+	defer ResetCallbacks()
+
+	first := RegisterCallback(func(x float64) float64 { return x })
+	if first == nil {
+		t.Fatal("RegisterCallback returned nil for the first slot in an empty pool")
+	}
+	for i := 1; i < callbackPoolSize; i++ {
+		if cb := RegisterCallback(func(x float64) float64 { return x }); cb == nil {
+			t.Fatalf("RegisterCallback returned nil at slot %d, before the pool was full", i)
+		}
+	}
+
+	if cb := RegisterCallback(func(x float64) float64 { return x }); cb != nil {
+		t.Fatalf("RegisterCallback = %v once the pool is full, want nil", cb)
+	}
+	if err := LastCallbackError(); err != ErrCallbacksExhausted {
+		t.Fatalf("LastCallbackError() = %v, want %v", err, ErrCallbacksExhausted)
+	}
+
+	UnregisterCallback(first)
+	if cb := RegisterCallback(func(x float64) float64 { return 2 * x }); cb == nil {
+		t.Fatal("RegisterCallback failed to reuse a slot freed by UnregisterCallback")
+	}
+	if got := float64(dispatchCallback(0, 21)); got != 42 {
+		t.Fatalf("dispatchCallback(0, 21) = %v, want 42", got)
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+/*
+#include <stdlib.h>
+#include "errors.h"
+
+// error_callback_t lets a C caller install a sink that is notified whenever
+// an exported function records a new error, in addition to it being
+// available via GetLastError/GetLastErrorCode.
+typedef void (*error_callback_t)(int code, const char* msg, void* userData);
+
+static void call_error_callback(error_callback_t cb, int code, const char* msg, void* userData) {
+    cb(code, msg, userData);
+}
+*/
+import "C"
+
+import (
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// errorState is the last error recorded for one OS thread.
+type errorState struct {
+	code C.int
+	msg  string
+}
+
+var (
+	errorMu       sync.Mutex
+	errorByThread = map[int]*errorState{}
+
+	errorCallbackMu   sync.RWMutex
+	errorCallback     C.error_callback_t
+	errorCallbackData unsafe.Pointer
+)
+
+// currentOSThread returns the id of the OS thread the calling goroutine is
+// currently running on. A goroutine invoked by cgo for an //export'd
+// function is already pinned to the C thread that called it for the
+// duration of that call, so no explicit runtime.LockOSThread is needed
+// here; calling it without a paired UnlockOSThread would instead leak the
+// thread once this goroutine eventually exits.
+func currentOSThread() int {
+	return syscall.Gettid()
+}
+
+// setLastError records code/msg as the last error for the calling OS thread
+// and, if one is installed, notifies the error callback.
+func setLastError(code C.int, msg string) {
+	tid := currentOSThread()
+
+	errorMu.Lock()
+	errorByThread[tid] = &errorState{code: code, msg: msg}
+	errorMu.Unlock()
+
+	errorCallbackMu.RLock()
+	cb := errorCallback
+	data := errorCallbackData
+	errorCallbackMu.RUnlock()
+	if cb != nil {
+		cMsg := C.CString(msg)
+		C.call_error_callback(cb, code, cMsg, data)
+		C.free(unsafe.Pointer(cMsg))
+	}
+}
+
+// clearLastError removes any recorded error for the calling OS thread. It
+// is the "success path" counterpart to setLastError, called at the top of
+// every validated exported function so a stale error can't outlive the call
+// that produced it.
+func clearLastError() {
+	tid := currentOSThread()
+	errorMu.Lock()
+	delete(errorByThread, tid)
+	errorMu.Unlock()
+}
+
+//export ClearLastError
+func ClearLastError() {
+	clearLastError()
+}
+
+//export GetLastError
+func GetLastError() *C.char {
+	tid := currentOSThread()
+	errorMu.Lock()
+	state := errorByThread[tid]
+	errorMu.Unlock()
+	if state == nil {
+		return nil
+	}
+	return C.CString(state.msg)
+}
+
+//export GetLastErrorCode
+func GetLastErrorCode() C.int {
+	tid := currentOSThread()
+	errorMu.Lock()
+	state := errorByThread[tid]
+	errorMu.Unlock()
+	if state == nil {
+		return C.FFI_OK
+	}
+	return state.code
+}
+
+//export SetErrorCallback
+func SetErrorCallback(cb C.error_callback_t, userData unsafe.Pointer) {
+	errorCallbackMu.Lock()
+	errorCallback = cb
+	errorCallbackData = userData
+	errorCallbackMu.Unlock()
+}
@@ -1,7 +1,9 @@
 package main
 
 /*
+#include <stdint.h>
 #include <stdlib.h>
+#include "errors.h"
 
 // Define a callback type that takes a double and returns a double.
 typedef double (*callback_t)(double);
@@ -21,40 +23,82 @@ static _Bool call_async_callback(async_callback_t cb, double result, void* userD
     return cb(result, userData);
 }
 
+// Define an indexed async callback type used by batch operations. The index
+// identifies which element of the batch the result belongs to. Returns true
+// if the caller is ready for more results, false to back-pressure the stream.
+typedef _Bool (*async_indexed_callback_t)(double result, int index, void* userData);
+
+// A helper function that calls the provided indexed async callback.
+static _Bool call_async_indexed_callback(async_indexed_callback_t cb, double result, int index, void* userData) {
+    return cb(result, index, userData);
+}
+
 // Define a Circle struct with a radius field.
 typedef struct {
     double radius;
 } Circle;
-
-// Define Shape enum type and values
-typedef enum {
-    SHAPE_CIRCLE = 0,
-    SHAPE_SQUARE = 1,
-    SHAPE_TRIANGLE = 2
-} ShapeType;
-
-// Define a Shape struct that includes the type and dimensions
-typedef struct {
-    ShapeType shape_type;
-    double dimension1; // radius for circle, side for square, base for triangle
-    double dimension2; // unused for circle/square, height for triangle
-} Shape;
 */
 import "C"
 import (
+	"context"
 	"fmt"
 	"math"
+	"sync"
+	"sync/atomic"
 	"time"
 	"unsafe"
 )
 
+// asyncCancellations tracks the cancel funcs for in-flight async operations,
+// keyed by the opaque handle returned to C callers.
+var asyncCancellations sync.Map // map[uint64]context.CancelFunc
+
+// nextAsyncHandle hands out monotonically increasing, non-zero cancellation
+// handles so C callers can treat 0 as "no handle".
+var nextAsyncHandle uint64
+
+// registerAsyncCancel allocates a new handle for ctx's cancel func and stores
+// it in asyncCancellations, returning the handle to hand back to C.
+func registerAsyncCancel(cancel context.CancelFunc) C.uint64_t {
+	handle := atomic.AddUint64(&nextAsyncHandle, 1)
+	asyncCancellations.Store(handle, cancel)
+	return C.uint64_t(handle)
+}
+
+// releaseAsyncCancel removes the bookkeeping entry for handle once the
+// associated goroutine has finished, cancelled or not.
+func releaseAsyncCancel(handle C.uint64_t) {
+	asyncCancellations.Delete(uint64(handle))
+}
+
+//export CancelAsync
+func CancelAsync(handle C.uint64_t) {
+	clearLastError()
+	cancel, ok := asyncCancellations.LoadAndDelete(uint64(handle))
+	if !ok {
+		setLastError(C.FFI_ERR_CANCELLED, "unknown or already-completed async handle")
+		return
+	}
+	cancel.(context.CancelFunc)()
+}
+
 //export CalculateCircleArea
 func CalculateCircleArea(radius C.double) C.double {
+	clearLastError()
+	if radius < 0 {
+		setLastError(C.FFI_ERR_INVALID_ARGUMENT, "radius must be non-negative")
+		return 0.0
+	}
 	return C.double(math.Pi * float64(radius) * float64(radius))
 }
 
 //export CalculateCircleStructArea
 func CalculateCircleStructArea(c C.Circle) C.double {
+	clearLastError()
+	if c.radius < 0 {
+		setLastError(C.FFI_ERR_INVALID_ARGUMENT, "radius must be non-negative")
+		return 0.0
+	}
 	// Convert the C.double field to a Go float64.
 	radius := float64(c.radius)
 	area := math.Pi * radius * radius
@@ -63,6 +107,11 @@ func CalculateCircleStructArea(c C.Circle) C.double {
 
 //export FormatCircleInfo
 func FormatCircleInfo(radius C.double) *C.char {
+	clearLastError()
+	if radius < 0 {
+		setLastError(C.FFI_ERR_INVALID_ARGUMENT, "radius must be non-negative")
+		return nil
+	}
 	area := CalculateCircleArea(radius)
 	result := fmt.Sprintf("Circle with radius %.2f has area %.2f", radius, area)
 	return C.CString(result)
@@ -75,51 +124,123 @@ func FreeString(str *C.char) {
 
 //export CallCallback
 func CallCallback(val C.double, cb C.callback_t) C.double {
+	clearLastError()
+	if cb == nil {
+		setLastError(C.FFI_ERR_NIL_CALLBACK, "callback must not be nil")
+		return 0.0
+	}
 	return C.call_callback(cb, val)
 }
 
 //export CalculateCircleAreaAsync
-func CalculateCircleAreaAsync(radius C.double, cb C.async_callback_t, userData unsafe.Pointer) {
+func CalculateCircleAreaAsync(radius C.double, cb C.async_callback_t, userData unsafe.Pointer) C.uint64_t {
+	clearLastError()
+	if cb == nil {
+		setLastError(C.FFI_ERR_NIL_CALLBACK, "callback must not be nil")
+		return 0
+	}
+	if radius < 0 {
+		setLastError(C.FFI_ERR_INVALID_ARGUMENT, "radius must be non-negative")
+		return 0
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	handle := registerAsyncCancel(cancel)
 	go func(r C.double, cb C.async_callback_t, userData unsafe.Pointer) {
-		// Simulate asynchronous delay.
-		time.Sleep(1 * time.Second)
+		defer releaseAsyncCancel(handle)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(1 * time.Second):
+		}
 		area := C.double(math.Pi * float64(r) * float64(r))
 		// Instead of converting the function pointer, call the helper C function.
 		C.call_async_callback(cb, area, userData)
 	}(radius, cb, userData)
+	return handle
 }
 
 //export CalculateCircleAreaAsyncMultiple
-func CalculateCircleAreaAsyncMultiple(radius C.double, cb C.async_callback_t, userData unsafe.Pointer) {
-    // Spawn a goroutine that calls the callback multiple times.
-    go func(r C.double, cb C.async_callback_t, userData unsafe.Pointer) {
-        // For example, call the callback three times (simulate multiple events).
-        for i := 0; i < 3; i++ {
-            time.Sleep(1 * time.Second)
-            // Calculate the area (same value each time in this example).
-            area := C.double(math.Pi * float64(r) * float64(r))
-            // Use the helper function to call the callback.
-            // If this is the last callback (i == 2), return false to signal completion
-            shouldContinue := bool(C.call_async_callback(cb, area, userData))
-            if !shouldContinue {
-                break
-            }
-        }
-    }(radius, cb, userData)
+func CalculateCircleAreaAsyncMultiple(radius C.double, cb C.async_callback_t, userData unsafe.Pointer) C.uint64_t {
+	clearLastError()
+	if cb == nil {
+		setLastError(C.FFI_ERR_NIL_CALLBACK, "callback must not be nil")
+		return 0
+	}
+	if radius < 0 {
+		setLastError(C.FFI_ERR_INVALID_ARGUMENT, "radius must be non-negative")
+		return 0
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	handle := registerAsyncCancel(cancel)
+	// Spawn a goroutine that calls the callback multiple times.
+	go func(r C.double, cb C.async_callback_t, userData unsafe.Pointer) {
+		defer releaseAsyncCancel(handle)
+		// For example, call the callback three times (simulate multiple events).
+		for i := 0; i < 3; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(1 * time.Second):
+			}
+			// Calculate the area (same value each time in this example).
+			area := C.double(math.Pi * float64(r) * float64(r))
+			// Use the helper function to call the callback.
+			// If this is the last callback (i == 2), return false to signal completion
+			shouldContinue := bool(C.call_async_callback(cb, area, userData))
+			if !shouldContinue {
+				break
+			}
+		}
+	}(radius, cb, userData)
+	return handle
 }
 
-//export CalculateShapeArea
-func CalculateShapeArea(shape C.Shape) C.double {
-    switch shape.shape_type {
-    case C.SHAPE_CIRCLE:
-        return C.double(math.Pi * float64(shape.dimension1) * float64(shape.dimension1))
-    case C.SHAPE_SQUARE:
-        return C.double(float64(shape.dimension1) * float64(shape.dimension1))
-    case C.SHAPE_TRIANGLE:
-        return C.double(0.5 * float64(shape.dimension1) * float64(shape.dimension2))
-    default:
-        return 0.0
-    }
+//export CalculateAsyncBatch
+func CalculateAsyncBatch(radii *C.double, n C.int, cb C.async_indexed_callback_t, userData unsafe.Pointer) C.uint64_t {
+	clearLastError()
+	if cb == nil {
+		setLastError(C.FFI_ERR_NIL_CALLBACK, "callback must not be nil")
+		return 0
+	}
+	if n < 0 || (n > 0 && radii == nil) {
+		setLastError(C.FFI_ERR_INVALID_ARGUMENT, "radii must be a valid array of n elements")
+		return 0
+	}
+	count := int(n)
+	// Copy the input slice up front: Go may not retain a pointer into C
+	// memory across the goroutine boundary once the caller's stack unwinds.
+	values := make([]float64, count)
+	for i, v := range unsafe.Slice(radii, count) {
+		values[i] = float64(v)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	handle := registerAsyncCancel(cancel)
+	go func(values []float64, cb C.async_indexed_callback_t, userData unsafe.Pointer) {
+		defer releaseAsyncCancel(handle)
+		for i, r := range values {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(200 * time.Millisecond):
+			}
+			area := math.Pi * r * r
+			// Retry the same element while the consumer signals back-pressure
+			// by returning false, backing off briefly between attempts.
+			for {
+				ready := bool(C.call_async_indexed_callback(cb, C.double(area), C.int(i), userData))
+				if ready {
+					break
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(50 * time.Millisecond):
+				}
+			}
+		}
+	}(values, cb, userData)
+	return handle
 }
 
 func main() {} // Required for a Go shared library
@@ -0,0 +1,235 @@
+package main
+
+/*
+#cgo LDFLAGS: -lm
+#include <stdlib.h>
+#include <math.h>
+#include "errors.h"
+
+// Define Shape enum type and values. Built-in shapes occupy the low ids;
+// callers registering their own shapes via RegisterShape should pick ids
+// above SHAPE_POLYGON to avoid colliding with them.
+typedef enum {
+    SHAPE_CIRCLE = 0,
+    SHAPE_SQUARE = 1,
+    SHAPE_TRIANGLE = 2,
+    SHAPE_RECTANGLE = 3,
+    SHAPE_ELLIPSE = 4,
+    SHAPE_POLYGON = 5
+} ShapeType;
+
+// Define a Shape struct that includes the type and dimensions. dimension1
+// and dimension2 cover the common two-parameter shapes; dimensions/
+// n_dimensions is an optional overflow payload for shapes whose computeArea
+// needs more than two values. When n_dimensions is 0 the dimensions pointer
+// is ignored and dimension1/dimension2 are used instead.
+typedef struct {
+    ShapeType shape_type;
+    double dimension1; // radius for circle, side for square, base for triangle/rectangle width
+    double dimension2; // unused for circle/square, height for triangle/rectangle, semi-minor axis for ellipse
+    double* dimensions; // optional packed dimensions for shapes with arity > 2
+    int n_dimensions;   // length of dimensions, or 0 to fall back to dimension1/dimension2
+} Shape;
+
+// computeArea/computePerimeter function pointers registered shapes are
+// invoked through. Both take a packed array of dimension values and its
+// length so the same signature works for any arity.
+typedef double (*shape_area_fn)(double* dims, int n);
+typedef double (*shape_perimeter_fn)(double* dims, int n);
+
+static double call_shape_fn(shape_area_fn fn, double* dims, int n) {
+    return fn(dims, n);
+}
+
+static double call_shape_perimeter_fn(shape_perimeter_fn fn, double* dims, int n) {
+    return fn(dims, n);
+}
+
+// Built-in area functions. Each is registered with RegisterShape at init()
+// the same way a caller would register a custom shape.
+static double circle_area(double* d, int n)    { return M_PI * d[0] * d[0]; }
+static double square_area(double* d, int n)    { return d[0] * d[0]; }
+static double triangle_area(double* d, int n)  { return 0.5 * d[0] * d[1]; }
+static double rectangle_area(double* d, int n) { return d[0] * d[1]; }
+static double ellipse_area(double* d, int n)   { return M_PI * d[0] * d[1]; }
+static double polygon_area(double* d, int n) {
+    // d[0] = side length, d[1] = number of sides
+    double side = d[0];
+    double sides = d[1];
+    return (sides * side * side) / (4.0 * tan(M_PI / sides));
+}
+
+static shape_area_fn circle_area_fn(void)    { return circle_area; }
+static shape_area_fn square_area_fn(void)    { return square_area; }
+static shape_area_fn triangle_area_fn(void)  { return triangle_area; }
+static shape_area_fn rectangle_area_fn(void) { return rectangle_area; }
+static shape_area_fn ellipse_area_fn(void)   { return ellipse_area; }
+static shape_area_fn polygon_area_fn(void)   { return polygon_area; }
+
+// Built-in perimeter functions, mirroring the area functions above.
+static double circle_perimeter(double* d, int n) { return 2.0 * M_PI * d[0]; }
+static double square_perimeter(double* d, int n) { return 4.0 * d[0]; }
+static double triangle_perimeter(double* d, int n) {
+    // Only base and height are known; approximate as an isosceles triangle.
+    double base = d[0];
+    double height = d[1];
+    double leg = sqrt((base / 2.0) * (base / 2.0) + height * height);
+    return base + 2.0 * leg;
+}
+static double rectangle_perimeter(double* d, int n) { return 2.0 * (d[0] + d[1]); }
+static double ellipse_perimeter(double* d, int n) {
+    // Ramanujan's approximation for the ellipse circumference.
+    double a = d[0];
+    double b = d[1];
+    double h = ((a - b) * (a - b)) / ((a + b) * (a + b));
+    return M_PI * (a + b) * (1.0 + (3.0 * h) / (10.0 + sqrt(4.0 - 3.0 * h)));
+}
+static double polygon_perimeter(double* d, int n) { return d[1] * d[0]; }
+
+static shape_perimeter_fn circle_perimeter_fn(void)    { return circle_perimeter; }
+static shape_perimeter_fn square_perimeter_fn(void)    { return square_perimeter; }
+static shape_perimeter_fn triangle_perimeter_fn(void)  { return triangle_perimeter; }
+static shape_perimeter_fn rectangle_perimeter_fn(void) { return rectangle_perimeter; }
+static shape_perimeter_fn ellipse_perimeter_fn(void)   { return ellipse_perimeter; }
+static shape_perimeter_fn polygon_perimeter_fn(void)   { return polygon_perimeter; }
+*/
+import "C"
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// shapeEntry is what RegisterShape stores for a shape type: its name, the
+// number of dimension values its computeArea function expects, and the C
+// function pointer that computes the area.
+type shapeEntry struct {
+	name        string
+	arity       int
+	computeArea C.shape_area_fn
+}
+
+var (
+	shapeMu           sync.RWMutex
+	shapeRegistry     = map[C.int]shapeEntry{}
+	shapePerimeterFns = map[C.int]C.shape_perimeter_fn{}
+)
+
+//export RegisterShape
+func RegisterShape(id C.int, name *C.char, arity C.int, computeArea unsafe.Pointer) {
+	clearLastError()
+	if computeArea == nil {
+		setLastError(C.FFI_ERR_INVALID_ARGUMENT, "computeArea must not be nil")
+		return
+	}
+	shapeMu.Lock()
+	defer shapeMu.Unlock()
+	shapeRegistry[id] = shapeEntry{
+		name:        C.GoString(name),
+		arity:       int(arity),
+		computeArea: C.shape_area_fn(computeArea),
+	}
+}
+
+// RegisterShapePerimeter adds (or replaces) the perimeter function for a
+// shape type, the same way RegisterShape does for area. Built-in shapes
+// register their perimeter function this same way at package init, through
+// the unexported registerShapePerimeter below.
+//
+//export RegisterShapePerimeter
+func RegisterShapePerimeter(id C.int, computePerimeter unsafe.Pointer) {
+	clearLastError()
+	if computePerimeter == nil {
+		setLastError(C.FFI_ERR_INVALID_ARGUMENT, "computePerimeter must not be nil")
+		return
+	}
+	registerShapePerimeter(id, C.shape_perimeter_fn(computePerimeter))
+}
+
+// registerShapePerimeter records the perimeter function for a shape type.
+func registerShapePerimeter(id C.int, fn C.shape_perimeter_fn) {
+	shapeMu.Lock()
+	defer shapeMu.Unlock()
+	shapePerimeterFns[id] = fn
+}
+
+func init() {
+	registerBuiltinShape(C.SHAPE_CIRCLE, "circle", 1, C.circle_area_fn(), C.circle_perimeter_fn())
+	registerBuiltinShape(C.SHAPE_SQUARE, "square", 1, C.square_area_fn(), C.square_perimeter_fn())
+	registerBuiltinShape(C.SHAPE_TRIANGLE, "triangle", 2, C.triangle_area_fn(), C.triangle_perimeter_fn())
+	registerBuiltinShape(C.SHAPE_RECTANGLE, "rectangle", 2, C.rectangle_area_fn(), C.rectangle_perimeter_fn())
+	registerBuiltinShape(C.SHAPE_ELLIPSE, "ellipse", 2, C.ellipse_area_fn(), C.ellipse_perimeter_fn())
+	registerBuiltinShape(C.SHAPE_POLYGON, "polygon", 2, C.polygon_area_fn(), C.polygon_perimeter_fn())
+}
+
+func registerBuiltinShape(id C.ShapeType, name string, arity int, area C.shape_area_fn, perimeter C.shape_perimeter_fn) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	RegisterShape(C.int(id), cName, C.int(arity), unsafe.Pointer(area))
+	registerShapePerimeter(C.int(id), perimeter)
+}
+
+// shapeDimensions packs a Shape's dimension values into a Go slice, copying
+// out of the overflow `dimensions` array (if present) rather than holding
+// onto the C pointer, per the cgo rule against retaining Go-unreachable
+// pointers beyond the call that produced them.
+func shapeDimensions(shape C.Shape) []float64 {
+	if shape.n_dimensions > 0 && shape.dimensions != nil {
+		raw := unsafe.Slice(shape.dimensions, int(shape.n_dimensions))
+		dims := make([]float64, len(raw))
+		for i, v := range raw {
+			dims[i] = float64(v)
+		}
+		return dims
+	}
+	return []float64{float64(shape.dimension1), float64(shape.dimension2)}
+}
+
+//export CalculateShapeArea
+func CalculateShapeArea(shape C.Shape) C.double {
+	clearLastError()
+	shapeMu.RLock()
+	entry, ok := shapeRegistry[C.int(shape.shape_type)]
+	shapeMu.RUnlock()
+	if !ok || entry.computeArea == nil {
+		setLastError(C.FFI_ERR_UNKNOWN_SHAPE, "unregistered shape type")
+		return 0.0
+	}
+	dims := shapeDimensions(shape)
+	if entry.arity > 0 && entry.arity < len(dims) {
+		dims = dims[:entry.arity]
+	}
+	return C.call_shape_fn(entry.computeArea, (*C.double)(unsafe.Pointer(&dims[0])), C.int(len(dims)))
+}
+
+//export CalculateShapePerimeter
+func CalculateShapePerimeter(shape C.Shape) C.double {
+	clearLastError()
+	shapeMu.RLock()
+	fn, ok := shapePerimeterFns[C.int(shape.shape_type)]
+	shapeMu.RUnlock()
+	if !ok || fn == nil {
+		setLastError(C.FFI_ERR_UNKNOWN_SHAPE, "unregistered shape type")
+		return 0.0
+	}
+	dims := shapeDimensions(shape)
+	return C.call_shape_perimeter_fn(fn, (*C.double)(unsafe.Pointer(&dims[0])), C.int(len(dims)))
+}
+
+//export CalculateShapeAreaBatch
+func CalculateShapeAreaBatch(shapes *C.Shape, n C.int, out *C.double) {
+	clearLastError()
+	if n < 0 || (n > 0 && (shapes == nil || out == nil)) {
+		setLastError(C.FFI_ERR_INVALID_ARGUMENT, "shapes and out must be valid arrays of n elements")
+		return
+	}
+	count := int(n)
+	if count == 0 {
+		return
+	}
+	in := unsafe.Slice(shapes, count)
+	results := unsafe.Slice(out, count)
+	for i := 0; i < count; i++ {
+		results[i] = CalculateShapeArea(in[i])
+	}
+}
@@ -0,0 +1,151 @@
+package main
+
+/*
+#include <stdint.h>
+#include <stdlib.h>
+#include <sys/types.h>
+#include "errors.h"
+*/
+import "C"
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sync"
+	"unsafe"
+)
+
+// FormatCircleInfoInto writes the same text FormatCircleInfo returns into a
+// caller-provided buffer instead of allocating one, so callers that would
+// otherwise need to pair every call with FreeString can avoid the per-string
+// free discipline entirely. Following snprintf semantics: on success it
+// returns the number of bytes written, excluding the nul terminator; if buf
+// is too small (or nil) to hold the formatted text plus its terminator, it
+// writes nothing more than fits and returns the negated number of bytes
+// that would have been required, so the caller can size a retry.
+//
+//export FormatCircleInfoInto
+func FormatCircleInfoInto(radius C.double, buf *C.char, capacity C.size_t) C.ssize_t {
+	clearLastError()
+	if radius < 0 {
+		setLastError(C.FFI_ERR_INVALID_ARGUMENT, "radius must be non-negative")
+		return -1
+	}
+
+	area := CalculateCircleArea(radius)
+	text := fmt.Sprintf("Circle with radius %.2f has area %.2f", radius, area)
+	needed := len(text)
+
+	if capacity > C.size_t(math.MaxInt) {
+		// capacity doesn't fit in an int on this platform; converting it
+		// anyway would wrap negative and crash the unsafe.Slice call below.
+		// Treat it the same as an invalid/zero capacity rather than trusting it.
+		setLastError(C.FFI_ERR_INVALID_ARGUMENT, "capacity is out of range")
+		return C.ssize_t(-(needed + 1))
+	}
+	cap := int(capacity)
+	if buf == nil || cap == 0 {
+		// snprintf(NULL, 0, ...) idiom: report the required size without writing.
+		return C.ssize_t(-(needed + 1))
+	}
+
+	dst := unsafe.Slice((*byte)(unsafe.Pointer(buf)), cap)
+	if needed+1 > cap {
+		n := copy(dst[:cap-1], text)
+		dst[n] = 0
+		return C.ssize_t(-(needed + 1))
+	}
+	n := copy(dst, text)
+	dst[n] = 0
+	return C.ssize_t(n)
+}
+
+// stringArena batches the lifetime of many C-heap strings behind a single
+// handle: every string returned by ArenaFormatCircleInfo for a given arena
+// is freed together by one FreeStringArena call, instead of requiring a
+// matching FreeString per string.
+type stringArena struct {
+	mu      sync.Mutex
+	scratch bytes.Buffer     // reused to build each string before it is copied onto the C heap
+	addrs   []unsafe.Pointer // C.malloc'd strings owned by this arena
+}
+
+var (
+	arenaMu   sync.Mutex
+	arenas    = map[C.uintptr_t]*stringArena{}
+	nextArena C.uintptr_t
+)
+
+// NewStringArena creates an empty arena and returns its handle.
+//
+//export NewStringArena
+func NewStringArena() C.uintptr_t {
+	arenaMu.Lock()
+	defer arenaMu.Unlock()
+	nextArena++
+	arenas[nextArena] = &stringArena{}
+	return nextArena
+}
+
+// ArenaFormatCircleInfo behaves like FormatCircleInfo, but the returned
+// string is owned by arena and must not be freed with FreeString; it is
+// reclaimed the next time FreeStringArena(arena) is called.
+//
+//export ArenaFormatCircleInfo
+func ArenaFormatCircleInfo(arena C.uintptr_t, radius C.double) *C.char {
+	clearLastError()
+
+	arenaMu.Lock()
+	a, ok := arenas[arena]
+	arenaMu.Unlock()
+	if !ok {
+		setLastError(C.FFI_ERR_INVALID_ARGUMENT, "unknown string arena")
+		return nil
+	}
+	if radius < 0 {
+		setLastError(C.FFI_ERR_INVALID_ARGUMENT, "radius must be non-negative")
+		return nil
+	}
+
+	area := CalculateCircleArea(radius)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.scratch.Reset()
+	fmt.Fprintf(&a.scratch, "Circle with radius %.2f has area %.2f", radius, area)
+	cStr := C.CString(a.scratch.String())
+	a.addrs = append(a.addrs, unsafe.Pointer(cStr))
+	return cStr
+}
+
+// FreeStringArena frees every string issued from arena in one call and
+// retires the handle. Freeing an unknown or already-freed arena is a no-op.
+//
+//export FreeStringArena
+func FreeStringArena(arena C.uintptr_t) {
+	arenaMu.Lock()
+	a, ok := arenas[arena]
+	if ok {
+		delete(arenas, arena)
+	}
+	arenaMu.Unlock()
+	if !ok {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, p := range a.addrs {
+		C.free(p)
+	}
+	a.addrs = nil
+}
+
+// goStringFromCString is a thin wrapper around C.GoString. It exists so
+// strings_test.go, which cannot itself import "C" (cgo is not supported in
+// _test.go files), can still inspect the contents of a *C.char produced by
+// this package's exported functions.
+func goStringFromCString(s *C.char) string {
+	return C.GoString(s)
+}
@@ -0,0 +1,44 @@
+package main
+
+import "testing"
+
+// TestFormatCircleInfoIntoTruncationAndOverflow covers the snprintf-style
+// contract of FormatCircleInfoInto: a nil/zero-capacity buffer reports the
+// required size without writing, a too-small buffer truncates and still
+// reports the required size, and a capacity that would overflow int is
+// rejected instead of being handed to unsafe.Slice.
+//
+// This file cannot itself import "C" (cgo is not supported in _test.go
+// files); it borrows *C.char values from FormatCircleInfo and reads them
+// back through goStringFromCString instead.
+func TestFormatCircleInfoIntoTruncationAndOverflow(t *testing.T) {
+	clearLastError()
+
+	if n := FormatCircleInfoInto(2, nil, 0); n >= 0 {
+		t.Fatalf("FormatCircleInfoInto(nil, 0) = %d, want a negative byte count", n)
+	}
+
+	buf := FormatCircleInfo(2)
+	defer FreeString(buf)
+
+	const tooSmall = 8
+	if n := FormatCircleInfoInto(2, buf, tooSmall); n >= 0 {
+		t.Fatalf("FormatCircleInfoInto with a too-small buffer = %d, want a negative byte count", n)
+	}
+	if got := goStringFromCString(buf); len(got) != tooSmall-1 {
+		t.Fatalf("truncated output %q has length %d, want %d", got, len(got), tooSmall-1)
+	}
+
+	clearLastError()
+	// The (size_t)-1 idiom: on a 64-bit size_t this is the largest value
+	// representable, which used to overflow int and crash the process.
+	const hugeCapacity = 18446744073709551615
+	if n := FormatCircleInfoInto(2, nil, hugeCapacity); n >= 0 {
+		t.Fatalf("FormatCircleInfoInto with an oversized capacity = %d, want a negative byte count", n)
+	}
+	msg := GetLastError()
+	if msg == nil {
+		t.Fatal("expected an error to be recorded for an oversized capacity")
+	}
+	FreeString(msg)
+}
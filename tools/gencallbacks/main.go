@@ -0,0 +1,110 @@
+// Command gencallbacks regenerates the fixed pool of cbThunkN trampolines
+// that back RegisterCallback in callbacks.go. Run it with `go generate`
+// (see the directive in callbacks.go) after changing poolSize below.
+//
+// The pool is split across two generated files rather than one:
+//
+//   - callbacks_gen.go defines thunk_addr itself (with external linkage, so
+//     callbacks.go can call it) and forward-declares every cbThunkN. It
+//     deliberately exports nothing, because cgo copies a file's preamble
+//     into the package's shared _cgo_export.c whenever that file has an
+//     //export'd function, and a non-static C function defined in two
+//     preambles is a duplicate-symbol link error.
+//   - callbacks_thunks_gen.go holds the actual //export cbThunkN
+//     definitions, which is the file cgo is allowed to duplicate.
+//
+// callbacks.go derives its callbackPoolSize from the generatedCallbackPoolSize
+// constant this program emits rather than hard-coding its own copy, so the
+// three files can never drift out of sync.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// poolSize is the number of live C-callable trampoline slots the generated
+// pool provides. The original request suggested an order of magnitude
+// around 2000; 2048 is used here as a round number in that range.
+const poolSize = 2048
+
+const (
+	addrOutputPath  = "callbacks_gen.go"
+	thunkOutputPath = "callbacks_thunks_gen.go"
+)
+
+func main() {
+	writeAddrFile()
+	writeThunkFile()
+}
+
+func writeAddrFile() {
+	f, err := os.Create(addrOutputPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gencallbacks:", err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	fmt.Fprint(f, "// Code generated by tools/gencallbacks; DO NOT EDIT.\n\n")
+	fmt.Fprint(f, "package main\n\n")
+	fmt.Fprint(f, "/*\n#include <stddef.h>\n\n")
+	fmt.Fprint(f, "// Forward declarations for the fixed pool of //export thunks defined in\n")
+	fmt.Fprint(f, "// callbacks_thunks_gen.go. Each one is a distinct C-ABI function so its\n")
+	fmt.Fprint(f, "// address can be handed to C as an ordinary callback_t, the same way\n")
+	fmt.Fprint(f, "// purego.NewCallback or the Windows runtime's compilecallback hand out\n")
+	fmt.Fprint(f, "// trampolines for Go closures.\n")
+	for i := 0; i < poolSize; i++ {
+		fmt.Fprintf(f, "extern double cbThunk%d(double);\n", i)
+	}
+	fmt.Fprint(f, "\n// thunk_addr returns the address of the Nth trampoline, built once on\n")
+	fmt.Fprint(f, "// first use so callbacks.go's RegisterCallback can hand it straight to C.\n")
+	fmt.Fprint(f, "// It has external linkage (unlike the rest of this package's static C\n")
+	fmt.Fprint(f, "// helpers) because callbacks.go's own preamble only forward-declares it;\n")
+	fmt.Fprint(f, "// this file defines it instead of callbacks_thunks_gen.go because only a\n")
+	fmt.Fprint(f, "// file with no //export functions is safe from cgo's _cgo_export.c\n")
+	fmt.Fprint(f, "// preamble duplication.\n")
+	fmt.Fprint(f, "void* thunk_addr(int i) {\n")
+	fmt.Fprintf(f, "    static void* addrs[%d];\n", poolSize)
+	fmt.Fprint(f, "    static int initialized = 0;\n")
+	fmt.Fprint(f, "    if (!initialized) {\n")
+	for i := 0; i < poolSize; i++ {
+		fmt.Fprintf(f, "        addrs[%d] = (void*)cbThunk%d;\n", i, i)
+	}
+	fmt.Fprint(f, "        initialized = 1;\n")
+	fmt.Fprint(f, "    }\n")
+	fmt.Fprint(f, "    return addrs[i];\n")
+	fmt.Fprint(f, "}\n")
+	fmt.Fprint(f, "*/\n")
+	fmt.Fprint(f, "import \"C\"\n\n")
+
+	fmt.Fprint(f, "// generatedCallbackPoolSize is how many cbThunkN functions the generated\n")
+	fmt.Fprint(f, "// files define; callbacks.go's callbackPoolSize is derived from it so the\n")
+	fmt.Fprint(f, "// two can never disagree about the size of the pool.\n")
+	fmt.Fprintf(f, "const generatedCallbackPoolSize = %d\n", poolSize)
+}
+
+func writeThunkFile() {
+	f, err := os.Create(thunkOutputPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gencallbacks:", err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	fmt.Fprint(f, "// Code generated by tools/gencallbacks; DO NOT EDIT.\n\n")
+	fmt.Fprint(f, "package main\n\n")
+	fmt.Fprint(f, "import \"C\"\n\n")
+	fmt.Fprint(f, "// Each cbThunkN below is a distinct exported C-ABI function whose address\n")
+	fmt.Fprint(f, "// thunk_addr (in callbacks_gen.go) hands out; dispatchCallback (in\n")
+	fmt.Fprint(f, "// callbacks.go) looks up which Go closure slot N is currently bound to.\n\n")
+	for i := 0; i < poolSize; i++ {
+		if i > 0 {
+			fmt.Fprint(f, "\n")
+		}
+		fmt.Fprintf(f, "//export cbThunk%d\n", i)
+		fmt.Fprintf(f, "func cbThunk%d(val C.double) C.double {\n", i)
+		fmt.Fprintf(f, "\treturn dispatchCallback(%d, val)\n", i)
+		fmt.Fprint(f, "}\n")
+	}
+}